@@ -0,0 +1,50 @@
+// Package clock abstracts time access so deadline-driven code in the
+// node's connection-handling paths can be unit tested deterministically
+// instead of racing against wall-clock time under CI load.
+package clock
+
+import "time"
+
+// Timer mirrors the subset of time.Timer a Clock needs to expose so that a
+// FakeTimer can be stopped and reset the same way a real one is.
+type Timer interface {
+	// C returns the channel the timer fires on.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, as time.Timer.Stop does.
+	Stop() bool
+	// Reset changes the timer to fire after d, as time.Timer.Reset does.
+	Reset(d time.Duration) bool
+}
+
+// Clock abstracts time.Now, time.After and time.NewTimer so that code which
+// needs to wait on or compare against the current time can be driven by a
+// FakeClock in tests instead of the wall clock.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// After returns a channel that receives the current time once d has
+	// elapsed.
+	After(d time.Duration) <-chan time.Time
+	// NewTimer starts a timer that sends the current time on its channel
+	// after d.
+	NewTimer(d time.Duration) Timer
+}
+
+// Real is the Clock backed by the wall clock and the time package. Node
+// code should take a Clock as a dependency and default to Real rather than
+// calling time.Now/time.After directly.
+var Real Clock = realClock{}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time { return time.Now() }
+
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+func (realClock) NewTimer(d time.Duration) Timer { return realTimer{time.NewTimer(d)} }
+
+type realTimer struct{ t *time.Timer }
+
+func (r realTimer) C() <-chan time.Time        { return r.t.C }
+func (r realTimer) Stop() bool                 { return r.t.Stop() }
+func (r realTimer) Reset(d time.Duration) bool { return r.t.Reset(d) }
@@ -0,0 +1,108 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// FakeClock is a Clock that only moves when Advance is called. Tests use it
+// to drive deadline- and latency-dependent code deterministically, without
+// sleeping real wall-clock time.
+type FakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []*fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+// NewFakeClock returns a FakeClock initially set to now.
+func NewFakeClock(now time.Time) *FakeClock {
+	return &FakeClock{now: now}
+}
+
+// Now implements Clock.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// After implements Clock.
+func (c *FakeClock) After(d time.Duration) <-chan time.Time {
+	return c.NewTimer(d).C()
+}
+
+// NewTimer implements Clock. The returned Timer fires once Advance moves
+// the fake clock to or past its deadline.
+func (c *FakeClock) NewTimer(d time.Duration) Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	w := &fakeWaiter{at: c.now.Add(d), ch: make(chan time.Time, 1)}
+	if d <= 0 {
+		w.ch <- c.now
+		return &fakeTimer{clock: c, waiter: w}
+	}
+	c.waiters = append(c.waiters, w)
+	return &fakeTimer{clock: c, waiter: w}
+}
+
+// Advance moves the fake clock forward by d, firing any pending timer
+// whose deadline has now been reached or passed.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	remaining := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.at.After(c.now) {
+			w.ch <- c.now
+			continue
+		}
+		remaining = append(remaining, w)
+	}
+	c.waiters = remaining
+}
+
+func (c *FakeClock) removeWaiter(w *fakeWaiter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, cur := range c.waiters {
+		if cur == w {
+			c.waiters = append(c.waiters[:i], c.waiters[i+1:]...)
+			return
+		}
+	}
+}
+
+type fakeTimer struct {
+	clock  *FakeClock
+	waiter *fakeWaiter
+}
+
+func (t *fakeTimer) C() <-chan time.Time { return t.waiter.ch }
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.removeWaiter(t.waiter)
+	return true
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.removeWaiter(t.waiter)
+	// A fired waiter's channel may still hold its buffered value if the
+	// caller never received it; time.Timer.Reset leaves draining to the
+	// caller, but here Reset drains the channel itself so the next Advance
+	// does not block sending into a full channel.
+	select {
+	case <-t.waiter.ch:
+	default:
+	}
+	t.clock.mu.Lock()
+	t.waiter.at = t.clock.now.Add(d)
+	t.clock.waiters = append(t.clock.waiters, t.waiter)
+	t.clock.mu.Unlock()
+	return true
+}
@@ -0,0 +1,92 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFakeClockNowAdvances(t *testing.T) {
+	start := time.Unix(0, 0)
+	c := NewFakeClock(start)
+	if !c.Now().Equal(start) {
+		t.Fatalf("Now() = %v, want %v", c.Now(), start)
+	}
+	c.Advance(time.Second)
+	if want := start.Add(time.Second); !c.Now().Equal(want) {
+		t.Fatalf("Now() after Advance = %v, want %v", c.Now(), want)
+	}
+}
+
+func TestFakeClockTimerFiresOnAdvancePastDeadline(t *testing.T) {
+	c := NewFakeClock(time.Unix(0, 0))
+	timer := c.NewTimer(5 * time.Second)
+
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	c.Advance(4 * time.Second)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	c.Advance(time.Second)
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("timer did not fire once the clock reached its deadline")
+	}
+}
+
+func TestFakeClockStopPreventsFire(t *testing.T) {
+	c := NewFakeClock(time.Unix(0, 0))
+	timer := c.NewTimer(time.Second)
+	timer.Stop()
+	c.Advance(time.Hour)
+	select {
+	case <-timer.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+}
+
+// TestFakeClockResetAfterFireDoesNotDeadlockAdvance guards against a
+// regression where Reset re-queued a waiter without draining its
+// already-fired, cap-1 channel: the next Advance would block forever
+// trying to send into the full channel.
+func TestFakeClockResetAfterFireDoesNotDeadlockAdvance(t *testing.T) {
+	c := NewFakeClock(time.Unix(0, 0))
+	timer := c.NewTimer(time.Second)
+
+	c.Advance(time.Second)
+	// Leave the fired value in the channel so Reset has to drain it.
+	if len(timer.C()) != 1 {
+		t.Fatal("timer did not fire on first Advance")
+	}
+
+	if !timer.Reset(time.Second) {
+		t.Fatal("Reset returned false")
+	}
+
+	done := make(chan struct{})
+	go func() {
+		c.Advance(time.Second)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Advance deadlocked after Reset on an already-fired timer")
+	}
+
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("reset timer did not fire after the second Advance")
+	}
+}
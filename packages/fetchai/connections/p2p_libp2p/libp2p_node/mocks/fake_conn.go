@@ -0,0 +1,347 @@
+// Package mocks also provides FakeConn, a hand-written net.Conn used to
+// exercise realistic failure modes (partial reads, slow peers, deadline
+// expiry, mid-handshake resets) that scripting MockConn's EXPECT() calls
+// cannot express cleanly.
+package mocks
+
+import (
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"libp2p_node/clock"
+)
+
+// EventKind identifies the kind of scripted Event a FakeConn replays.
+type EventKind int
+
+const (
+	// DeliverBytes appends Data to the conn's pending read buffer, as if
+	// the remote peer had sent it.
+	DeliverBytes EventKind = iota
+	// Latency delays the next Read or Write by Delay before it completes.
+	Latency
+	// FailRead causes the next Read to return Err instead of reading.
+	FailRead
+	// FailWrite causes the next Write to return Err instead of writing.
+	FailWrite
+	// HalfClose causes Read to return io.EOF once the buffered data has
+	// been drained, while Write keeps delivering to the peer.
+	HalfClose
+	// FullClose closes the conn for both Read and Write.
+	FullClose
+)
+
+// Event is a single scripted step queued onto a FakeConn via Script.
+type Event struct {
+	Kind  EventKind
+	Data  []byte
+	Delay time.Duration
+	Err   error
+}
+
+type fakeAddr string
+
+func (a fakeAddr) Network() string { return "fakeconn" }
+func (a fakeAddr) String() string  { return string(a) }
+
+// FakeConn is a net.Conn implementation driven by a scripted sequence of
+// Events instead of hand-scripted mock expectations. Use NewFakeConn for a
+// standalone conn fed entirely through DeliverBytes events, or NewPipe to
+// wire two FakeConns together so writes on one surface as reads on the
+// other, the way the node code and a simulated peer would talk over a real
+// socket.
+//
+// Deadlines and scripted Latency are evaluated against the conn's Clock
+// (time.Now/time.After by default), so a test can inject a clock.FakeClock
+// with SetClock and drive timeout paths by calling Advance instead of
+// sleeping real time.
+type FakeConn struct {
+	mu      sync.Mutex
+	changed chan struct{}
+
+	clock clock.Clock
+	peer  *FakeConn
+
+	buf        []byte
+	pending    []Event
+	closed     bool
+	readClosed bool
+
+	readDeadline  time.Time
+	writeDeadline time.Time
+
+	localAddr  net.Addr
+	remoteAddr net.Addr
+}
+
+// NewFakeConn returns a standalone FakeConn with no peer. Inbound data must
+// be supplied with Script(Event{Kind: DeliverBytes, ...}).
+func NewFakeConn() *FakeConn {
+	return &FakeConn{
+		changed:    make(chan struct{}),
+		clock:      clock.Real,
+		localAddr:  fakeAddr("fakeconn-local"),
+		remoteAddr: fakeAddr("fakeconn-remote"),
+	}
+}
+
+// NewPipe returns two FakeConns wired together: bytes written to one are
+// delivered to the other's Read, in either direction.
+func NewPipe() (*FakeConn, *FakeConn) {
+	a, b := NewFakeConn(), NewFakeConn()
+	a.peer, b.peer = b, a
+	return a, b
+}
+
+// SetClock overrides the Clock this conn consults for deadlines and
+// scripted Latency. Tests pass a clock.FakeClock to make timeout paths
+// deterministic.
+func (c *FakeConn) SetClock(clk clock.Clock) {
+	c.mu.Lock()
+	c.clock = clk
+	c.mu.Unlock()
+}
+
+// Script queues events to be replayed by this conn's Read and Write calls,
+// in the order given. DeliverBytes, HalfClose and FullClose take effect
+// immediately; Latency, FailRead and FailWrite are consumed by the next
+// matching Read or Write call.
+func (c *FakeConn) Script(events ...Event) {
+	c.mu.Lock()
+	for _, ev := range events {
+		switch ev.Kind {
+		case DeliverBytes:
+			c.buf = append(c.buf, ev.Data...)
+		case HalfClose:
+			c.readClosed = true
+		case FullClose:
+			c.closed = true
+		default:
+			c.pending = append(c.pending, ev)
+		}
+	}
+	c.notifyLocked()
+	c.mu.Unlock()
+}
+
+// notifyLocked wakes any goroutine blocked in wait. Callers must hold c.mu.
+func (c *FakeConn) notifyLocked() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+// takePending removes and returns the first not-yet-consumed event of kind,
+// or nil if none is queued. Callers must hold c.mu.
+func (c *FakeConn) takePending(kind EventKind) *Event {
+	for i, ev := range c.pending {
+		if ev.Kind == kind {
+			c.pending = append(c.pending[:i], c.pending[i+1:]...)
+			return &ev
+		}
+	}
+	return nil
+}
+
+// deadlineExceeded reports whether deadline has already passed according
+// to clk. Callers must capture clk under c.mu (e.g. alongside the deadline
+// itself) rather than reading c.clock directly, since SetClock may run
+// concurrently with a blocked Read/Write.
+func (c *FakeConn) deadlineExceeded(clk clock.Clock, deadline time.Time) bool {
+	return !deadline.IsZero() && !clk.Now().Before(deadline)
+}
+
+// sleep blocks for d according to clk.
+func (c *FakeConn) sleep(clk clock.Clock, d time.Duration) {
+	if d <= 0 {
+		return
+	}
+	timer := clk.NewTimer(d)
+	defer timer.Stop()
+	<-timer.C()
+}
+
+// applyLatency blocks for ev.Delay, or until deadline if that comes first,
+// in which case it returns os.ErrDeadlineExceeded.
+func (c *FakeConn) applyLatency(clk clock.Clock, ev Event, deadline time.Time) error {
+	if deadline.IsZero() {
+		c.sleep(clk, ev.Delay)
+		return nil
+	}
+	remaining := deadline.Sub(clk.Now())
+	if remaining <= 0 {
+		return os.ErrDeadlineExceeded
+	}
+	if ev.Delay >= remaining {
+		c.sleep(clk, remaining)
+		return os.ErrDeadlineExceeded
+	}
+	c.sleep(clk, ev.Delay)
+	return nil
+}
+
+// wait blocks until ch is closed (Script, Close, a deadline setter, or a
+// Write or Close on the peer ran) or until deadline elapses on clk,
+// whichever comes first.
+func (c *FakeConn) wait(clk clock.Clock, ch <-chan struct{}, deadline time.Time) {
+	if deadline.IsZero() {
+		<-ch
+		return
+	}
+	remaining := deadline.Sub(clk.Now())
+	if remaining <= 0 {
+		return
+	}
+	timer := clk.NewTimer(remaining)
+	defer timer.Stop()
+	select {
+	case <-ch:
+	case <-timer.C():
+	}
+}
+
+// Read implements net.Conn.
+func (c *FakeConn) Read(p []byte) (int, error) {
+	for {
+		c.mu.Lock()
+		if c.closed {
+			c.mu.Unlock()
+			return 0, io.ErrClosedPipe
+		}
+		if ev := c.takePending(FailRead); ev != nil {
+			c.mu.Unlock()
+			return 0, ev.Err
+		}
+		if ev := c.takePending(Latency); ev != nil {
+			deadline := c.readDeadline
+			clk := c.clock
+			c.mu.Unlock()
+			if err := c.applyLatency(clk, *ev, deadline); err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if len(c.buf) > 0 {
+			n := copy(p, c.buf)
+			c.buf = c.buf[n:]
+			c.mu.Unlock()
+			return n, nil
+		}
+		if c.readClosed {
+			c.mu.Unlock()
+			return 0, io.EOF
+		}
+		if c.deadlineExceeded(c.clock, c.readDeadline) {
+			c.mu.Unlock()
+			return 0, os.ErrDeadlineExceeded
+		}
+		deadline := c.readDeadline
+		clk := c.clock
+		ch := c.changed
+		c.mu.Unlock()
+		c.wait(clk, ch, deadline)
+	}
+}
+
+// Write implements net.Conn.
+func (c *FakeConn) Write(p []byte) (int, error) {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return 0, io.ErrClosedPipe
+	}
+	if ev := c.takePending(FailWrite); ev != nil {
+		c.mu.Unlock()
+		return 0, ev.Err
+	}
+	var latency *Event
+	if ev := c.takePending(Latency); ev != nil {
+		latency = ev
+	}
+	deadline := c.writeDeadline
+	clk := c.clock
+	c.mu.Unlock()
+
+	if latency != nil {
+		if err := c.applyLatency(clk, *latency, deadline); err != nil {
+			return 0, err
+		}
+	}
+	if c.deadlineExceeded(clk, deadline) {
+		return 0, os.ErrDeadlineExceeded
+	}
+
+	peer := c.peer
+	if peer == nil {
+		return len(p), nil
+	}
+
+	data := append([]byte(nil), p...)
+	peer.mu.Lock()
+	if peer.closed {
+		peer.mu.Unlock()
+		return 0, io.ErrClosedPipe
+	}
+	peer.buf = append(peer.buf, data...)
+	peer.notifyLocked()
+	peer.mu.Unlock()
+	return len(p), nil
+}
+
+// Close implements net.Conn. Closing one side of a pipe half-closes the
+// other for reads: the peer's Read drains any buffered data and then
+// returns io.EOF.
+func (c *FakeConn) Close() error {
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		return nil
+	}
+	c.closed = true
+	c.notifyLocked()
+	c.mu.Unlock()
+
+	if c.peer != nil {
+		c.peer.mu.Lock()
+		c.peer.readClosed = true
+		c.peer.notifyLocked()
+		c.peer.mu.Unlock()
+	}
+	return nil
+}
+
+// LocalAddr implements net.Conn.
+func (c *FakeConn) LocalAddr() net.Addr { return c.localAddr }
+
+// RemoteAddr implements net.Conn.
+func (c *FakeConn) RemoteAddr() net.Addr { return c.remoteAddr }
+
+// SetDeadline implements net.Conn.
+func (c *FakeConn) SetDeadline(t time.Time) error {
+	c.mu.Lock()
+	c.readDeadline = t
+	c.writeDeadline = t
+	c.notifyLocked()
+	c.mu.Unlock()
+	return nil
+}
+
+// SetReadDeadline implements net.Conn.
+func (c *FakeConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	c.readDeadline = t
+	c.notifyLocked()
+	c.mu.Unlock()
+	return nil
+}
+
+// SetWriteDeadline implements net.Conn.
+func (c *FakeConn) SetWriteDeadline(t time.Time) error {
+	c.mu.Lock()
+	c.writeDeadline = t
+	c.notifyLocked()
+	c.mu.Unlock()
+	return nil
+}
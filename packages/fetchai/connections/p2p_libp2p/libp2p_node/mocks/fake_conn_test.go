@@ -0,0 +1,172 @@
+package mocks
+
+import (
+	"errors"
+	"io"
+	"os"
+	"testing"
+	"time"
+
+	"libp2p_node/clock"
+)
+
+func TestFakeConnDeliverBytes(t *testing.T) {
+	c := NewFakeConn()
+	c.Script(Event{Kind: DeliverBytes, Data: []byte("hello")})
+
+	buf := make([]byte, 5)
+	n, err := c.Read(buf)
+	if err != nil {
+		t.Fatalf("Read returned error: %v", err)
+	}
+	if string(buf[:n]) != "hello" {
+		t.Fatalf("Read = %q, want %q", buf[:n], "hello")
+	}
+}
+
+func TestFakeConnFailRead(t *testing.T) {
+	c := NewFakeConn()
+	wantErr := errors.New("boom")
+	c.Script(Event{Kind: FailRead, Err: wantErr})
+
+	_, err := c.Read(make([]byte, 1))
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Read err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestFakeConnFailWrite(t *testing.T) {
+	c := NewFakeConn()
+	wantErr := errors.New("boom")
+	c.Script(Event{Kind: FailWrite, Err: wantErr})
+
+	_, err := c.Write([]byte("x"))
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("Write err = %v, want %v", err, wantErr)
+	}
+}
+
+func TestFakeConnHalfClose(t *testing.T) {
+	c := NewFakeConn()
+	c.Script(Event{Kind: DeliverBytes, Data: []byte("hi")}, Event{Kind: HalfClose})
+
+	buf := make([]byte, 2)
+	n, err := c.Read(buf)
+	if err != nil || string(buf[:n]) != "hi" {
+		t.Fatalf("Read = %q, %v, want %q, nil", buf[:n], err, "hi")
+	}
+
+	if _, err := c.Read(buf); err != io.EOF {
+		t.Fatalf("Read after drain = %v, want io.EOF", err)
+	}
+	if _, err := c.Write([]byte("still writable")); err != nil {
+		t.Fatalf("Write after HalfClose = %v, want nil", err)
+	}
+}
+
+func TestFakeConnFullClose(t *testing.T) {
+	c := NewFakeConn()
+	c.Script(Event{Kind: FullClose})
+
+	if _, err := c.Read(make([]byte, 1)); err != io.ErrClosedPipe {
+		t.Fatalf("Read after FullClose = %v, want io.ErrClosedPipe", err)
+	}
+	if _, err := c.Write([]byte("x")); err != io.ErrClosedPipe {
+		t.Fatalf("Write after FullClose = %v, want io.ErrClosedPipe", err)
+	}
+}
+
+func TestFakeConnPipeDeliversAcrossPeers(t *testing.T) {
+	a, b := NewPipe()
+
+	if _, err := a.Write([]byte("ping")); err != nil {
+		t.Fatalf("a.Write: %v", err)
+	}
+	buf := make([]byte, 4)
+	n, err := b.Read(buf)
+	if err != nil || string(buf[:n]) != "ping" {
+		t.Fatalf("b.Read = %q, %v, want %q, nil", buf[:n], err, "ping")
+	}
+
+	a.Close()
+	if _, err := b.Read(make([]byte, 1)); err != io.EOF {
+		t.Fatalf("b.Read after a.Close = %v, want io.EOF", err)
+	}
+}
+
+func TestFakeConnLatencyWithFakeClock(t *testing.T) {
+	c := NewFakeConn()
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	c.SetClock(clk)
+	c.Script(Event{Kind: Latency, Delay: 5 * time.Second}, Event{Kind: DeliverBytes, Data: []byte("x")})
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := c.Read(make([]byte, 1))
+		result <- err
+	}()
+
+	// Give the goroutine a chance to block on the scripted latency before
+	// advancing; the FakeClock only moves when Advance is called, so the
+	// read cannot complete until it observes the advance below.
+	time.Sleep(20 * time.Millisecond)
+	clk.Advance(5 * time.Second)
+
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Fatalf("Read returned error: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Read did not complete after the fake clock advanced past the scripted latency")
+	}
+}
+
+func TestFakeConnReadDeadlineExpiresOnFakeClock(t *testing.T) {
+	c := NewFakeConn()
+	clk := clock.NewFakeClock(time.Unix(0, 0))
+	c.SetClock(clk)
+	c.SetReadDeadline(clk.Now().Add(time.Second))
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := c.Read(make([]byte, 1))
+		result <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	clk.Advance(time.Second)
+
+	select {
+	case err := <-result:
+		if !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.Fatalf("Read err = %v, want os.ErrDeadlineExceeded", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Read did not return after the fake clock advanced past the deadline")
+	}
+}
+
+// TestFakeConnSetClockRaceWithBlockedRead guards against a regression
+// where a blocked Read/Write read c.clock without holding c.mu, racing
+// with a concurrent SetClock. Run with -race to catch it.
+func TestFakeConnSetClockRaceWithBlockedRead(t *testing.T) {
+	a, _ := NewPipe()
+	a.SetReadDeadline(time.Now().Add(time.Hour))
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		a.Read(make([]byte, 1))
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	a.SetClock(clock.NewFakeClock(time.Now()))
+	a.Close()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		t.Fatal("Read did not return after Close")
+	}
+}
@@ -1,7 +1,12 @@
 // Code generated by MockGen. DO NOT EDIT.
 // Source: net (interfaces: Conn)
+//
+// Generated by this command:
+//
+//	mockgen -typed -destination=mocks/mock_net.go -package=mocks net Conn
+//
 
-// Package mock_net is a generated GoMock package.
+// Package mocks is a generated GoMock package.
 package mocks
 
 import (
@@ -9,7 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 
-	gomock "github.com/golang/mock/gomock"
+	gomock "go.uber.org/mock/gomock"
 )
 
 // MockConn is a mock of Conn interface.
@@ -44,9 +49,33 @@ func (m *MockConn) Close() error {
 }
 
 // Close indicates an expected call of Close.
-func (mr *MockConnMockRecorder) Close() *gomock.Call {
+func (mr *MockConnMockRecorder) Close() *MockConnCloseCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConn)(nil).Close))
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockConn)(nil).Close))
+	return &MockConnCloseCall{Call: call}
+}
+
+// MockConnCloseCall wraps *gomock.Call.
+type MockConnCloseCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnCloseCall) Return(arg0 error) *MockConnCloseCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnCloseCall) Do(f func() error) *MockConnCloseCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnCloseCall) DoAndReturn(f func() error) *MockConnCloseCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }
 
 // LocalAddr mocks base method.
@@ -58,9 +87,33 @@ func (m *MockConn) LocalAddr() net.Addr {
 }
 
 // LocalAddr indicates an expected call of LocalAddr.
-func (mr *MockConnMockRecorder) LocalAddr() *gomock.Call {
+func (mr *MockConnMockRecorder) LocalAddr() *MockConnLocalAddrCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalAddr", reflect.TypeOf((*MockConn)(nil).LocalAddr))
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LocalAddr", reflect.TypeOf((*MockConn)(nil).LocalAddr))
+	return &MockConnLocalAddrCall{Call: call}
+}
+
+// MockConnLocalAddrCall wraps *gomock.Call.
+type MockConnLocalAddrCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnLocalAddrCall) Return(arg0 net.Addr) *MockConnLocalAddrCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnLocalAddrCall) Do(f func() net.Addr) *MockConnLocalAddrCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnLocalAddrCall) DoAndReturn(f func() net.Addr) *MockConnLocalAddrCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }
 
 // Read mocks base method.
@@ -73,9 +126,33 @@ func (m *MockConn) Read(arg0 []byte) (int, error) {
 }
 
 // Read indicates an expected call of Read.
-func (mr *MockConnMockRecorder) Read(arg0 interface{}) *gomock.Call {
+func (mr *MockConnMockRecorder) Read(arg0 any) *MockConnReadCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockConn)(nil).Read), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockConn)(nil).Read), arg0)
+	return &MockConnReadCall{Call: call}
+}
+
+// MockConnReadCall wraps *gomock.Call.
+type MockConnReadCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnReadCall) Return(arg0 int, arg1 error) *MockConnReadCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnReadCall) Do(f func([]byte) (int, error)) *MockConnReadCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnReadCall) DoAndReturn(f func([]byte) (int, error)) *MockConnReadCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }
 
 // RemoteAddr mocks base method.
@@ -87,9 +164,33 @@ func (m *MockConn) RemoteAddr() net.Addr {
 }
 
 // RemoteAddr indicates an expected call of RemoteAddr.
-func (mr *MockConnMockRecorder) RemoteAddr() *gomock.Call {
+func (mr *MockConnMockRecorder) RemoteAddr() *MockConnRemoteAddrCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoteAddr", reflect.TypeOf((*MockConn)(nil).RemoteAddr))
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoteAddr", reflect.TypeOf((*MockConn)(nil).RemoteAddr))
+	return &MockConnRemoteAddrCall{Call: call}
+}
+
+// MockConnRemoteAddrCall wraps *gomock.Call.
+type MockConnRemoteAddrCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnRemoteAddrCall) Return(arg0 net.Addr) *MockConnRemoteAddrCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnRemoteAddrCall) Do(f func() net.Addr) *MockConnRemoteAddrCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnRemoteAddrCall) DoAndReturn(f func() net.Addr) *MockConnRemoteAddrCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }
 
 // SetDeadline mocks base method.
@@ -101,9 +202,33 @@ func (m *MockConn) SetDeadline(arg0 time.Time) error {
 }
 
 // SetDeadline indicates an expected call of SetDeadline.
-func (mr *MockConnMockRecorder) SetDeadline(arg0 interface{}) *gomock.Call {
+func (mr *MockConnMockRecorder) SetDeadline(arg0 any) *MockConnSetDeadlineCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeadline", reflect.TypeOf((*MockConn)(nil).SetDeadline), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDeadline", reflect.TypeOf((*MockConn)(nil).SetDeadline), arg0)
+	return &MockConnSetDeadlineCall{Call: call}
+}
+
+// MockConnSetDeadlineCall wraps *gomock.Call.
+type MockConnSetDeadlineCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnSetDeadlineCall) Return(arg0 error) *MockConnSetDeadlineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnSetDeadlineCall) Do(f func(time.Time) error) *MockConnSetDeadlineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnSetDeadlineCall) DoAndReturn(f func(time.Time) error) *MockConnSetDeadlineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }
 
 // SetReadDeadline mocks base method.
@@ -115,9 +240,33 @@ func (m *MockConn) SetReadDeadline(arg0 time.Time) error {
 }
 
 // SetReadDeadline indicates an expected call of SetReadDeadline.
-func (mr *MockConnMockRecorder) SetReadDeadline(arg0 interface{}) *gomock.Call {
+func (mr *MockConnMockRecorder) SetReadDeadline(arg0 any) *MockConnSetReadDeadlineCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadDeadline", reflect.TypeOf((*MockConn)(nil).SetReadDeadline), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetReadDeadline", reflect.TypeOf((*MockConn)(nil).SetReadDeadline), arg0)
+	return &MockConnSetReadDeadlineCall{Call: call}
+}
+
+// MockConnSetReadDeadlineCall wraps *gomock.Call.
+type MockConnSetReadDeadlineCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnSetReadDeadlineCall) Return(arg0 error) *MockConnSetReadDeadlineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnSetReadDeadlineCall) Do(f func(time.Time) error) *MockConnSetReadDeadlineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnSetReadDeadlineCall) DoAndReturn(f func(time.Time) error) *MockConnSetReadDeadlineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }
 
 // SetWriteDeadline mocks base method.
@@ -129,9 +278,33 @@ func (m *MockConn) SetWriteDeadline(arg0 time.Time) error {
 }
 
 // SetWriteDeadline indicates an expected call of SetWriteDeadline.
-func (mr *MockConnMockRecorder) SetWriteDeadline(arg0 interface{}) *gomock.Call {
+func (mr *MockConnMockRecorder) SetWriteDeadline(arg0 any) *MockConnSetWriteDeadlineCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteDeadline", reflect.TypeOf((*MockConn)(nil).SetWriteDeadline), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWriteDeadline", reflect.TypeOf((*MockConn)(nil).SetWriteDeadline), arg0)
+	return &MockConnSetWriteDeadlineCall{Call: call}
+}
+
+// MockConnSetWriteDeadlineCall wraps *gomock.Call.
+type MockConnSetWriteDeadlineCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnSetWriteDeadlineCall) Return(arg0 error) *MockConnSetWriteDeadlineCall {
+	c.Call = c.Call.Return(arg0)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnSetWriteDeadlineCall) Do(f func(time.Time) error) *MockConnSetWriteDeadlineCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnSetWriteDeadlineCall) DoAndReturn(f func(time.Time) error) *MockConnSetWriteDeadlineCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }
 
 // Write mocks base method.
@@ -144,7 +317,31 @@ func (m *MockConn) Write(arg0 []byte) (int, error) {
 }
 
 // Write indicates an expected call of Write.
-func (mr *MockConnMockRecorder) Write(arg0 interface{}) *gomock.Call {
+func (mr *MockConnMockRecorder) Write(arg0 any) *MockConnWriteCall {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockConn)(nil).Write), arg0)
+	call := mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Write", reflect.TypeOf((*MockConn)(nil).Write), arg0)
+	return &MockConnWriteCall{Call: call}
+}
+
+// MockConnWriteCall wraps *gomock.Call.
+type MockConnWriteCall struct {
+	*gomock.Call
+}
+
+// Return rewrites *gomock.Call.Return.
+func (c *MockConnWriteCall) Return(arg0 int, arg1 error) *MockConnWriteCall {
+	c.Call = c.Call.Return(arg0, arg1)
+	return c
+}
+
+// Do rewrites *gomock.Call.Do.
+func (c *MockConnWriteCall) Do(f func([]byte) (int, error)) *MockConnWriteCall {
+	c.Call = c.Call.Do(f)
+	return c
+}
+
+// DoAndReturn rewrites *gomock.Call.DoAndReturn.
+func (c *MockConnWriteCall) DoAndReturn(f func([]byte) (int, error)) *MockConnWriteCall {
+	c.Call = c.Call.DoAndReturn(f)
+	return c
 }